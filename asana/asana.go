@@ -0,0 +1,231 @@
+// Package asana provides a client for the Asana API.
+package asana
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultBaseURL = "https://app.asana.com/api/1.0/"
+	userAgent      = "go-asana"
+)
+
+// Client manages communication with the Asana API.
+type Client struct {
+	client *http.Client
+
+	// BaseURL is the base URL for API requests. Defaults to the public
+	// Asana API, but can be overridden (e.g. for testing) to point at
+	// another endpoint.
+	BaseURL *url.URL
+
+	// UserAgent is sent in the User-Agent header on all requests.
+	UserAgent string
+
+	// Tasks provides iteration helpers built on top of Client's task
+	// methods, such as ListTasks.
+	Tasks *TasksService
+
+	// RetryPolicy governs automatic retries of requests that fail with a
+	// 429 or 5xx response. NewClient installs a default policy; set this to
+	// nil to disable retries entirely.
+	RetryPolicy *RetryPolicy
+
+	// Logger, if set, receives structured diagnostic output for each
+	// request.
+	Logger Logger
+
+	// Tracer, if set, is called around every HTTP round trip, including
+	// retries.
+	Tracer *HTTPTracer
+}
+
+// NewClient returns a new Asana API client. If httpClient is nil,
+// http.DefaultClient is used.
+func NewClient(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	baseURL, _ := url.Parse(defaultBaseURL)
+
+	c := &Client{
+		client:      httpClient,
+		BaseURL:     baseURL,
+		UserAgent:   userAgent,
+		RetryPolicy: defaultRetryPolicy(),
+	}
+	c.Tasks = &TasksService{client: c}
+
+	return c
+}
+
+// Envelope wraps the "data" field that Asana uses to wrap every response
+// body, along with the "next_page" object used for pagination.
+type Envelope struct {
+	Data     interface{} `json:"data"`
+	NextPage *Page       `json:"next_page,omitempty"`
+}
+
+// NewRequest creates an API request against urlStr, which is resolved
+// relative to the client's BaseURL. If body is non-nil, it is JSON encoded
+// and used as the request body.
+func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
+	rel, err := url.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+	u := c.BaseURL.ResolveReference(rel)
+
+	var buf *bytes.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		buf = bytes.NewReader(b)
+	} else {
+		buf = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequest(method, u.String(), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	return req, nil
+}
+
+// NewFormRequest creates an API request against urlStr with values encoded
+// as an application/x-www-form-urlencoded body, as required by Asana's
+// create endpoints.
+func (c *Client) NewFormRequest(method, urlStr string, values url.Values) (*http.Request, error) {
+	rel, err := url.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+	u := c.BaseURL.ResolveReference(rel)
+
+	req, err := http.NewRequest(method, u.String(), strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	return req, nil
+}
+
+// withQuery appends opt as a URL query string to path, if opt is non-empty.
+func withQuery(path string, opt url.Values) string {
+	if len(opt) == 0 {
+		return path
+	}
+	return path + "?" + opt.Encode()
+}
+
+// Do sends an API request and, on success, decodes the "data" field of the
+// response body into v. The caller may pass a nil v to ignore the response
+// body. The returned Response carries pagination and rate-limit
+// information alongside the underlying *http.Response.
+//
+// If c.RetryPolicy is set, requests that fail with a 429 or 5xx response are
+// retried transparently, honoring any Retry-After header and respecting
+// ctx's deadline.
+func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	req = req.WithContext(ctx)
+
+	for attempt := 0; ; attempt++ {
+		resp, err := c.doOnce(req, v)
+		if !c.RetryPolicy.shouldRetry(req.Method, resp, attempt) {
+			return resp, err
+		}
+
+		var httpResp *http.Response
+		if resp != nil {
+			httpResp = resp.Response
+		}
+		if c.Logger != nil {
+			c.Logger.Warn("asana: retrying request", "method", req.Method, "url", req.URL.String(), "attempt", attempt+1, "err", err)
+		}
+		if c.RetryPolicy.OnRetry != nil {
+			c.RetryPolicy.OnRetry(attempt+1, httpResp, err)
+		}
+
+		if werr := waitContext(ctx, c.RetryPolicy.delay(resp, attempt)); werr != nil {
+			return resp, werr
+		}
+
+		if req.Body != nil && req.GetBody != nil {
+			body, berr := req.GetBody()
+			if berr != nil {
+				return resp, berr
+			}
+			req.Body = body
+		}
+	}
+}
+
+// doOnce sends req exactly once and decodes the response, without any
+// retry handling.
+func (c *Client) doOnce(req *http.Request, v interface{}) (*Response, error) {
+	if c.Logger != nil {
+		c.Logger.Debug("asana: sending request", "method", req.Method, "url", req.URL.String())
+	}
+	if c.Tracer != nil && c.Tracer.OnRequest != nil {
+		c.Tracer.OnRequest(req)
+	}
+
+	start := time.Now()
+	httpResp, err := c.client.Do(req)
+	if err != nil {
+		if c.Logger != nil {
+			c.Logger.Error("asana: request failed", "method", req.Method, "url", req.URL.String(), "err", err)
+		}
+		if c.Tracer != nil && c.Tracer.OnResponse != nil {
+			c.Tracer.OnResponse(nil, time.Since(start))
+		}
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if c.Tracer != nil && c.Tracer.OnResponse != nil {
+		c.Tracer.OnResponse(httpResp, time.Since(start))
+	}
+
+	resp := &Response{Response: httpResp}
+	resp.populateRateLimit(httpResp.Header)
+
+	if err := checkResponse(httpResp); err != nil {
+		if c.Logger != nil {
+			c.Logger.Warn("asana: request returned error status", "method", req.Method, "url", req.URL.String(), "status", httpResp.StatusCode)
+		}
+		return resp, err
+	}
+
+	if v != nil {
+		env := Envelope{Data: v}
+		if err := json.NewDecoder(httpResp.Body).Decode(&env); err != nil {
+			return resp, err
+		}
+		resp.NextPage = env.NextPage
+	}
+
+	return resp, nil
+}
@@ -2,6 +2,7 @@ package asana
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -9,6 +10,7 @@ import (
 	"net/url"
 	"reflect"
 	"testing"
+	"time"
 )
 
 var (
@@ -64,8 +66,8 @@ func TestListWorkspaces(t *testing.T) {
 	}
 
 	want := []Workspace{
-		{ID: 1, Name: "Organization 1"},
-		{ID: 2, Name: "Organization 2"},
+		{ID: 1, GID: "1", Name: "Organization 1"},
+		{ID: 2, GID: "2", Name: "Organization 2"},
 	}
 
 	if !reflect.DeepEqual(workspaces, want) {
@@ -84,14 +86,14 @@ func TestListUsers(t *testing.T) {
 		]}`)
 	})
 
-	users, err := client.ListUsers(context.Background(), nil)
+	users, _, err := client.ListUsers(context.Background(), nil)
 	if err != nil {
 		t.Errorf("ListUsers returned error: %v", err)
 	}
 
 	want := []User{
-		{ID: 1, Email: "test1@asana.com"},
-		{ID: 2, Email: "test2@asana.com"},
+		{ID: 1, GID: "1", Email: "test1@asana.com"},
+		{ID: 2, GID: "2", Email: "test2@asana.com"},
 	}
 
 	if !reflect.DeepEqual(users, want) {
@@ -110,14 +112,14 @@ func TestListProjects(t *testing.T) {
 		]}`)
 	})
 
-	projects, err := client.ListProjects(context.Background(), nil)
+	projects, _, err := client.ListProjects(context.Background(), nil)
 	if err != nil {
 		t.Errorf("ListProjects returned error: %v", err)
 	}
 
 	want := []Project{
-		{ID: 1, Name: "Project 1", Team: &Team{GID: "3232", Name: "Team 1"}},
-		{ID: 2, Name: "Project 2"},
+		{ID: 1, GID: "1", Name: "Project 1", Team: &Team{GID: "3232", Name: "Team 1"}},
+		{ID: 2, GID: "2", Name: "Project 2"},
 	}
 
 	if !reflect.DeepEqual(projects, want) {
@@ -136,14 +138,14 @@ func TestListTasks(t *testing.T) {
 		]}`)
 	})
 
-	tasks, err := client.ListTasks(context.Background(), nil)
+	tasks, _, err := client.ListTasks(context.Background(), nil)
 	if err != nil {
 		t.Errorf("ListTasks returned error: %v", err)
 	}
 
 	want := []Task{
-		{ID: 1, Name: "Task 1"},
-		{ID: 2, Name: "Task 2"},
+		{ID: 1, GID: "1", Name: "Task 1"},
+		{ID: 2, GID: "2", Name: "Task 2"},
 	}
 
 	if !reflect.DeepEqual(tasks, want) {
@@ -151,6 +153,108 @@ func TestListTasks(t *testing.T) {
 	}
 }
 
+func TestListTasksWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tasks", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, map[string]string{
+			"limit":      "50",
+			"offset":     "abc123",
+			"opt_fields": "name,completed",
+			"opt_expand": "assignee",
+		})
+		fmt.Fprint(w, `{"data":[{"id":1,"name":"Task 1"}]}`)
+	})
+
+	opt := &TaskListOptions{
+		ListOptions: ListOptions{Limit: 50, Offset: "abc123"},
+		QueryOptions: QueryOptions{
+			Fields: []string{"name", "completed"},
+			Expand: []string{"assignee"},
+		},
+	}
+
+	_, _, err := client.ListTasks(context.Background(), opt)
+	if err != nil {
+		t.Errorf("ListTasks returned error: %v", err)
+	}
+}
+
+func TestListTasksNextPage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tasks", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[{"id":1,"name":"Task 1"}],"next_page":{"offset":"abc123","path":"/tasks?offset=abc123","uri":"https://app.asana.com/api/1.0/tasks?offset=abc123"}}`)
+	})
+
+	_, resp, err := client.ListTasks(context.Background(), nil)
+	if err != nil {
+		t.Errorf("ListTasks returned error: %v", err)
+	}
+
+	want := &Page{Offset: "abc123", Path: "/tasks?offset=abc123", URI: "https://app.asana.com/api/1.0/tasks?offset=abc123"}
+	if !reflect.DeepEqual(resp.NextPage, want) {
+		t.Errorf("ListTasks Response.NextPage = %+v, want %+v", resp.NextPage, want)
+	}
+}
+
+func TestListTasksRateLimit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tasks", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.Header().Set("Retry-Remaining", "42")
+		fmt.Fprint(w, `{"data":[{"id":1,"name":"Task 1"}]}`)
+	})
+
+	_, resp, err := client.ListTasks(context.Background(), nil)
+	if err != nil {
+		t.Errorf("ListTasks returned error: %v", err)
+	}
+
+	if resp.RateLimit.RetryAfter != 30 {
+		t.Errorf("Response.RateLimit.RetryAfter = %d, want %d", resp.RateLimit.RetryAfter, 30)
+	}
+	if resp.RateLimit.Remaining != 42 {
+		t.Errorf("Response.RateLimit.Remaining = %d, want %d", resp.RateLimit.Remaining, 42)
+	}
+}
+
+func TestTasksListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("/tasks", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Query().Get("offset") == "" {
+			fmt.Fprint(w, `{"data":[{"id":1,"name":"Task 1"}],"next_page":{"offset":"page2"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"id":2,"name":"Task 2"}]}`)
+	})
+
+	var got []Task
+	err := client.Tasks.ListAll(context.Background(), nil, func(tasks []Task) error {
+		got = append(got, tasks...)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("ListAll returned error: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("ListAll made %d requests, want 2", requests)
+	}
+
+	want := []Task{{ID: 1, GID: "1", Name: "Task 1"}, {ID: 2, GID: "2", Name: "Task 2"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListAll collected %+v, want %+v", got, want)
+	}
+}
+
 func TestUpdateTask(t *testing.T) {
 	setup()
 	defer teardown()
@@ -184,12 +288,54 @@ func TestUpdateTask(t *testing.T) {
 		t.Errorf("UpdateTask returned error: %v", err)
 	}
 
-	want := Task{ID: 1, Notes: "updated notes"}
+	want := Task{ID: 1, GID: "1", Notes: "updated notes"}
 	if !reflect.DeepEqual(task, want) {
 		t.Errorf("UpdateTask returned %+v, want %+v", task, want)
 	}
 }
 
+func TestTaskUnmarshalIDAndGID(t *testing.T) {
+	var byID Task
+	if err := json.Unmarshal([]byte(`{"id":1}`), &byID); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	if want := (Task{ID: 1, GID: "1"}); byID != want {
+		t.Errorf("Unmarshal of {\"id\":1} = %+v, want %+v", byID, want)
+	}
+
+	var byGID Task
+	if err := json.Unmarshal([]byte(`{"gid":"1"}`), &byGID); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	if want := (Task{ID: 1, GID: "1"}); byGID != want {
+		t.Errorf("Unmarshal of {\"gid\":\"1\"} = %+v, want %+v", byGID, want)
+	}
+}
+
+func TestUpdateTaskByGID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var called int
+	defer func() { testCalled(t, called, 1) }()
+	mux.HandleFunc("/tasks/abc123", func(w http.ResponseWriter, r *http.Request) {
+		called++
+		testMethod(t, r, "PUT")
+		fmt.Fprint(w, `{"data":{"gid":"abc123","notes":"updated notes"}}`)
+	})
+
+	notes := "updated notes"
+	task, err := client.UpdateTaskByGID(context.Background(), "abc123", TaskUpdate{Notes: &notes}, nil)
+	if err != nil {
+		t.Errorf("UpdateTaskByGID returned error: %v", err)
+	}
+
+	want := Task{GID: "abc123", Notes: "updated notes"}
+	if !reflect.DeepEqual(task, want) {
+		t.Errorf("UpdateTaskByGID returned %+v, want %+v", task, want)
+	}
+}
+
 func TestListTags(t *testing.T) {
 	setup()
 	defer teardown()
@@ -201,14 +347,14 @@ func TestListTags(t *testing.T) {
 		]}`)
 	})
 
-	tags, err := client.ListTags(context.Background(), nil)
+	tags, _, err := client.ListTags(context.Background(), nil)
 	if err != nil {
 		t.Errorf("ListTags returned error: %v", err)
 	}
 
 	want := []Tag{
-		{ID: 1, Name: "Tag 1"},
-		{ID: 2, Name: "Tag 2"},
+		{ID: 1, GID: "1", Name: "Tag 1"},
+		{ID: 2, GID: "2", Name: "Tag 2"},
 	}
 
 	if !reflect.DeepEqual(tags, want) {
@@ -224,7 +370,7 @@ func TestUnauthorized(t *testing.T) {
 		w.WriteHeader(http.StatusUnauthorized)
 	})
 
-	_, err := client.ListTags(context.Background(), nil)
+	_, _, err := client.ListTags(context.Background(), nil)
 	if err == nil {
 		t.Error("No error when one was expected")
 	}
@@ -237,6 +383,138 @@ func TestUnauthorized(t *testing.T) {
 	}
 }
 
+func TestTracerOnResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tags", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Millisecond)
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	var calls int
+	var gotStatus int
+	var gotLatency time.Duration
+	client.Tracer = &HTTPTracer{
+		OnResponse: func(resp *http.Response, latency time.Duration) {
+			calls++
+			if resp != nil {
+				gotStatus = resp.StatusCode
+			}
+			gotLatency = latency
+		},
+	}
+
+	_, _, err := client.ListTags(context.Background(), nil)
+	if err == nil {
+		t.Error("No error when one was expected")
+	}
+	if calls != 1 {
+		t.Errorf("OnResponse called %d times, want 1", calls)
+	}
+	if gotStatus != http.StatusUnauthorized {
+		t.Errorf("OnResponse status = %d, want %d", gotStatus, http.StatusUnauthorized)
+	}
+	if gotLatency <= 0 {
+		t.Errorf("OnResponse latency = %v, want > 0", gotLatency)
+	}
+}
+
+func TestRetryOnRateLimit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RetryPolicy = &RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond}
+
+	var requests int
+	mux.HandleFunc("/tags", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests <= 2 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"id":1,"name":"Tag 1"}]}`)
+	})
+
+	var retries int
+	client.RetryPolicy.OnRetry = func(attempt int, resp *http.Response, err error) {
+		retries++
+		if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+			t.Errorf("OnRetry resp status = %v, want %d", resp, http.StatusTooManyRequests)
+		}
+	}
+
+	tags, _, err := client.ListTags(context.Background(), nil)
+	if err != nil {
+		t.Errorf("ListTags returned error: %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("ListTags made %d requests, want 3", requests)
+	}
+	if retries != 2 {
+		t.Errorf("OnRetry called %d times, want 2", retries)
+	}
+
+	want := []Tag{{ID: 1, GID: "1", Name: "Tag 1"}}
+	if !reflect.DeepEqual(tags, want) {
+		t.Errorf("ListTags returned %+v, want %+v", tags, want)
+	}
+}
+
+func TestRetryWaitsForAdvertisedRetryAfter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RetryPolicy = &RetryPolicy{MaxRetries: 1}
+
+	var requests int
+	mux.HandleFunc("/tags", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"id":1,"name":"Tag 1"}]}`)
+	})
+
+	start := time.Now()
+	_, _, err := client.ListTags(context.Background(), nil)
+	elapsed := time.Since(start)
+
+	if err != nil {
+		t.Errorf("ListTags returned error: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("ListTags made %d requests, want 2", requests)
+	}
+	if elapsed < time.Second {
+		t.Errorf("ListTags returned after %v, want it to have waited at least the advertised %v", elapsed, time.Second)
+	}
+}
+
+func TestNoRetryOnPOSTByDefault(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RetryPolicy = &RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond}
+
+	var requests int
+	mux.HandleFunc("/tasks", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := client.CreateTask(context.Background(), map[string]string{"name": "Task 1"}, nil)
+	if err == nil {
+		t.Error("No error when one was expected")
+	}
+	if requests != 1 {
+		t.Errorf("CreateTask made %d requests, want 1 (POST is not retried by default)", requests)
+	}
+}
+
 func TestCreateTask(t *testing.T) {
 	setup()
 	defer teardown()
@@ -275,7 +553,7 @@ func TestCreateTask(t *testing.T) {
 		t.Errorf("CreateTask returned error: %v", err)
 	}
 
-	want := Task{ID: 1, Notes: "updated notes"}
+	want := Task{ID: 1, GID: "1", Notes: "updated notes"}
 	if !reflect.DeepEqual(task, want) {
 		t.Errorf("CreateTask returned %+v, want %+v", task, want)
 	}
@@ -296,7 +574,8 @@ func TestGetWebhook(t *testing.T) {
 
 	want := Webhook{
 		ID:       1,
-		Resource: Resource{ID: 5, Name: "Project X"},
+		GID:      "1",
+		Resource: Resource{ID: 5, GID: "5", Name: "Project X"},
 		Target:   "http://site.com/webhook/666",
 		Active:   true,
 	}
@@ -314,7 +593,7 @@ func TestGetWebhooks(t *testing.T) {
 		fmt.Fprint(w, `{"data":[{"id":1,"resource":{"id":5,"name":"Project X"},"target":"http://site.com/webhook/666","active":true},{"id":2,"resource":{"id":6,"name":"Project Y"},"target":"http://site.com/webhook/555","active":true}]}`)
 	})
 
-	webhooks, err := client.GetWebhooks(context.Background(), nil)
+	webhooks, _, err := client.GetWebhooks(context.Background(), nil)
 	if err != nil {
 		t.Errorf("GetWebhooks returned error: %v", err)
 	}
@@ -322,13 +601,15 @@ func TestGetWebhooks(t *testing.T) {
 	want := []Webhook{
 		{
 			ID:       1,
-			Resource: Resource{ID: 5, Name: "Project X"},
+			GID:      "1",
+			Resource: Resource{ID: 5, GID: "5", Name: "Project X"},
 			Target:   "http://site.com/webhook/666",
 			Active:   true,
 		},
 		{
 			ID:       2,
-			Resource: Resource{ID: 6, Name: "Project Y"},
+			GID:      "2",
+			Resource: Resource{ID: 6, GID: "6", Name: "Project Y"},
 			Target:   "http://site.com/webhook/555",
 			Active:   true,
 		},
@@ -376,7 +657,8 @@ func TestCreateWebhook(t *testing.T) {
 
 	want := Webhook{
 		ID:       3,
-		Resource: Resource{ID: 123, Name: "Project Z"},
+		GID:      "3",
+		Resource: Resource{ID: 123, GID: "123", Name: "Project Z"},
 		Target:   "http://server.com/webhook",
 		Active:   true,
 	}
@@ -417,6 +699,19 @@ func testHeader(t *testing.T, r *http.Request, header string, want string) {
 	}
 }
 
+func testFormValues(t *testing.T, r *http.Request, want map[string]string) {
+	got := r.URL.Query()
+
+	wantValues := url.Values{}
+	for k, v := range want {
+		wantValues.Set(k, v)
+	}
+
+	if !reflect.DeepEqual(got, wantValues) {
+		t.Errorf("Request query: %v, want %v", got, wantValues)
+	}
+}
+
 func testCalled(t *testing.T, called int, want int) {
 	if got := called; got != want {
 		t.Errorf("handler was called %v times, but expected to be called %v times", got, want)
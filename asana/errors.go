@@ -0,0 +1,54 @@
+package asana
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// Error represents a single error returned by the Asana API.
+type Error struct {
+	Phrase  string `json:"phrase"`
+	Message string `json:"message"`
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("%s - %s", e.Message, e.Phrase)
+}
+
+// RequestError reports a failed API request, including the HTTP status
+// code and any errors returned in the response body.
+type RequestError struct {
+	Code   int
+	Errors []Error
+}
+
+func (r *RequestError) Error() string {
+	if len(r.Errors) > 0 {
+		return fmt.Sprintf("asana: request failed with status %d: %s", r.Code, r.Errors[0])
+	}
+	return fmt.Sprintf("asana: request failed with status %d", r.Code)
+}
+
+// checkResponse returns a *RequestError if resp's status code is outside
+// the 2xx range, nil otherwise.
+func checkResponse(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		return nil
+	}
+
+	rerr := &RequestError{Code: resp.StatusCode}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err == nil && len(b) > 0 {
+		var envelope struct {
+			Errors []Error `json:"errors"`
+		}
+		if jsonErr := json.Unmarshal(b, &envelope); jsonErr == nil {
+			rerr.Errors = envelope.Errors
+		}
+	}
+
+	return rerr
+}
@@ -0,0 +1,42 @@
+package asana
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// decodeIdentity reconciles Asana's numeric id and string gid
+// representations of the same identifier. Asana is migrating resources from
+// numeric ids to string gids, and may send either (or, during the
+// transition, both). rawID and rawGID are the raw "id"/"gid" JSON values, if
+// present; either may be nil.
+//
+// A numeric id is stringified into gid when gid is absent; a string gid
+// that parses as a number populates id when id is absent.
+func decodeIdentity(rawID, rawGID json.RawMessage) (id int64, gid string, err error) {
+	if len(rawGID) > 0 && string(rawGID) != "null" {
+		if err := json.Unmarshal(rawGID, &gid); err != nil {
+			return 0, "", err
+		}
+	}
+
+	if len(rawID) > 0 && string(rawID) != "null" {
+		var n json.Number
+		if err := json.Unmarshal(rawID, &n); err != nil {
+			return 0, "", err
+		}
+		id, err = strconv.ParseInt(n.String(), 10, 64)
+		if err != nil {
+			return 0, "", err
+		}
+		if gid == "" {
+			gid = n.String()
+		}
+	} else if gid != "" {
+		if parsed, perr := strconv.ParseInt(gid, 10, 64); perr == nil {
+			id = parsed
+		}
+	}
+
+	return id, gid, nil
+}
@@ -0,0 +1,11 @@
+package asana
+
+// Logger receives structured diagnostic output from a Client. Each method
+// takes a message and an even number of key-value pairs describing the
+// event, in the style of popular structured logging packages.
+type Logger interface {
+	Debug(msg string, keyvals ...interface{})
+	Info(msg string, keyvals ...interface{})
+	Warn(msg string, keyvals ...interface{})
+	Error(msg string, keyvals ...interface{})
+}
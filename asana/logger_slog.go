@@ -0,0 +1,20 @@
+//go:build go1.21
+
+package asana
+
+import "log/slog"
+
+// SlogLogger adapts a *slog.Logger to the Logger interface. *slog.Logger
+// already implements Debug/Info/Warn/Error with a (msg string, args ...any)
+// signature, so it satisfies Logger directly once embedded.
+type SlogLogger struct {
+	*slog.Logger
+}
+
+// NewSlogLogger returns a Logger backed by l. A nil l uses slog.Default().
+func NewSlogLogger(l *slog.Logger) *SlogLogger {
+	if l == nil {
+		l = slog.Default()
+	}
+	return &SlogLogger{Logger: l}
+}
@@ -0,0 +1,164 @@
+package asana
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ListOptions holds the pagination parameters shared by every list
+// endpoint.
+type ListOptions struct {
+	// Limit caps the number of results per page.
+	Limit int `url:"limit,omitempty"`
+
+	// Offset resumes a listing from a previous Response.NextPage.Offset.
+	Offset string `url:"offset,omitempty"`
+}
+
+// QueryOptions holds the sparse-fieldset and formatting parameters Asana
+// accepts on every read endpoint.
+type QueryOptions struct {
+	// Fields restricts the response to these top-level fields, sent as
+	// opt_fields.
+	Fields []string `url:"opt_fields,comma,omitempty"`
+
+	// Expand requests full objects (rather than compact references) for
+	// these fields, sent as opt_expand.
+	Expand []string `url:"opt_expand,comma,omitempty"`
+
+	// Pretty requests indented JSON, sent as opt_pretty.
+	Pretty bool `url:"opt_pretty,omitempty"`
+}
+
+// TaskListOptions holds the query parameters accepted by ListTasks.
+type TaskListOptions struct {
+	ListOptions
+	QueryOptions
+}
+
+// ProjectListOptions holds the query parameters accepted by ListProjects.
+type ProjectListOptions struct {
+	ListOptions
+	QueryOptions
+}
+
+// UserListOptions holds the query parameters accepted by ListUsers.
+type UserListOptions struct {
+	ListOptions
+	QueryOptions
+}
+
+// WebhookListOptions holds the query parameters accepted by GetWebhooks.
+type WebhookListOptions struct {
+	ListOptions
+	QueryOptions
+}
+
+// addOptions encodes the non-zero fields of opt, a struct tagged with
+// `url:"name,comma,omitempty"` (following the go-querystring convention),
+// as query parameters appended to path. opt may be nil, or a nil pointer,
+// in which case path is returned unchanged.
+func addOptions(path string, opt interface{}) (string, error) {
+	v := reflect.ValueOf(opt)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return path, nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return path, nil
+	}
+
+	values := url.Values{}
+	if err := encodeStruct(v, values); err != nil {
+		return "", err
+	}
+	if len(values) == 0 {
+		return path, nil
+	}
+
+	u, err := url.Parse(path)
+	if err != nil {
+		return "", err
+	}
+	u.RawQuery = values.Encode()
+
+	return u.String(), nil
+}
+
+// encodeStruct walks v's fields, adding each tagged field to values.
+// Anonymous struct fields (embedded ListOptions/QueryOptions) are recursed
+// into so their tags are honored as if they were declared directly on v.
+func encodeStruct(v reflect.Value, values url.Values) error {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fv := v.Field(i)
+
+		tag := field.Tag.Get("url")
+		if tag == "-" {
+			continue
+		}
+		if tag == "" {
+			if field.Anonymous && fv.Kind() == reflect.Struct {
+				if err := encodeStruct(fv, values); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		name, opts := parseTag(tag)
+		if opts.omitempty && fv.IsZero() {
+			continue
+		}
+
+		switch fv.Kind() {
+		case reflect.Slice, reflect.Array:
+			if fv.Len() == 0 {
+				continue
+			}
+			if opts.comma {
+				parts := make([]string, fv.Len())
+				for i := range parts {
+					parts[i] = fmt.Sprint(fv.Index(i).Interface())
+				}
+				values.Set(name, strings.Join(parts, ","))
+			} else {
+				for i := 0; i < fv.Len(); i++ {
+					values.Add(name, fmt.Sprint(fv.Index(i).Interface()))
+				}
+			}
+		case reflect.Bool:
+			values.Set(name, strconv.FormatBool(fv.Bool()))
+		default:
+			values.Set(name, fmt.Sprint(fv.Interface()))
+		}
+	}
+
+	return nil
+}
+
+type tagOptions struct {
+	omitempty bool
+	comma     bool
+}
+
+func parseTag(tag string) (name string, opts tagOptions) {
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	for _, p := range parts[1:] {
+		switch p {
+		case "omitempty":
+			opts.omitempty = true
+		case "comma":
+			opts.comma = true
+		}
+	}
+	return name, opts
+}
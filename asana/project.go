@@ -0,0 +1,65 @@
+package asana
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Team represents an Asana team that a project belongs to.
+type Team struct {
+	GID  string `json:"gid"`
+	Name string `json:"name"`
+}
+
+// Project represents an Asana project.
+type Project struct {
+	ID   int64  `json:"id"`
+	GID  string `json:"gid"`
+	Name string `json:"name"`
+	Team *Team  `json:"team,omitempty"`
+}
+
+// UnmarshalJSON decodes a Project, reconciling a numeric id and string gid
+// into both ID and GID regardless of which the server sent.
+func (p *Project) UnmarshalJSON(data []byte) error {
+	type alias Project
+	aux := &struct {
+		ID  json.RawMessage `json:"id"`
+		GID json.RawMessage `json:"gid"`
+		*alias
+	}{alias: (*alias)(p)}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	id, gid, err := decodeIdentity(aux.ID, aux.GID)
+	if err != nil {
+		return err
+	}
+	p.ID, p.GID = id, gid
+
+	return nil
+}
+
+// ListProjects returns the projects visible to the authenticated user. opt
+// may be nil.
+func (c *Client) ListProjects(ctx context.Context, opt *ProjectListOptions) ([]Project, *Response, error) {
+	u, err := addOptions("projects", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var projects []Project
+	resp, err := c.Do(ctx, req, &projects)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return projects, resp, nil
+}
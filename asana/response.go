@@ -0,0 +1,52 @@
+package asana
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// Page describes a page of results past the one just fetched, as reported
+// by Asana's "next_page" object.
+type Page struct {
+	Offset string `json:"offset"`
+	Path   string `json:"path"`
+	URI    string `json:"uri"`
+}
+
+// RateLimit reports the rate-limit information Asana attaches to a
+// response.
+type RateLimit struct {
+	// RetryAfter is the number of seconds in the Retry-After header, which
+	// Asana sends on a 429 response. It is read on every response; zero if
+	// the header was absent or not a whole number of seconds.
+	RetryAfter int
+
+	// Remaining is the number of requests left in the current window, from
+	// the Retry-Remaining header. Zero if the header was absent.
+	Remaining int
+}
+
+// Response wraps the *http.Response for an API call, adding Asana's
+// pagination and rate-limit information.
+//
+// There is no PrevPage: Asana's "next_page" object only ever points
+// forward, so a page fetched by following NextPage.Offset has no offset to
+// go back with.
+type Response struct {
+	*http.Response
+
+	// NextPage is non-nil when more results are available; pass
+	// NextPage.Offset as the "offset" option to fetch the next page.
+	NextPage *Page
+
+	RateLimit RateLimit
+}
+
+func (r *Response) populateRateLimit(h http.Header) {
+	if v, err := strconv.Atoi(h.Get("Retry-After")); err == nil {
+		r.RateLimit.RetryAfter = v
+	}
+	if v, err := strconv.Atoi(h.Get("Retry-Remaining")); err == nil {
+		r.RateLimit.Remaining = v
+	}
+}
@@ -0,0 +1,135 @@
+package asana
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures automatic retries for requests that fail with a
+// 429 or 5xx response. The zero value retries nothing; set Client.RetryPolicy
+// to nil to disable the default policy installed by NewClient.
+type RetryPolicy struct {
+	// MaxRetries caps the number of additional attempts after the initial
+	// request.
+	MaxRetries int
+
+	// BaseDelay is the starting delay used by the exponential backoff when
+	// the response carries no Retry-After header.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the computed delay, including any Retry-After value.
+	MaxDelay time.Duration
+
+	// Jitter adds up to this much additional random delay to each backoff,
+	// to avoid thundering-herd retries across clients.
+	Jitter time.Duration
+
+	// RetryPOST allows retrying POST requests, which are not idempotent by
+	// default. GET, PUT, and DELETE are always eligible.
+	RetryPOST bool
+
+	// OnRetry, if set, is called before each retry with the 1-based attempt
+	// number and the response or error that triggered it.
+	OnRetry func(attempt int, resp *http.Response, err error)
+}
+
+// defaultRetryPolicy is installed on every Client returned by NewClient.
+func defaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries: 3,
+		BaseDelay:  500 * time.Millisecond,
+		MaxDelay:   30 * time.Second,
+		Jitter:     250 * time.Millisecond,
+	}
+}
+
+// shouldRetry reports whether resp/err warrants another attempt under p for
+// a request using method, given how many retries have already happened.
+func (p *RetryPolicy) shouldRetry(method string, resp *Response, attempt int) bool {
+	if p == nil || resp == nil {
+		return false
+	}
+	if attempt >= p.MaxRetries {
+		return false
+	}
+
+	code := resp.StatusCode
+	if code != http.StatusTooManyRequests && (code < 500 || code > 599) {
+		return false
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		return p.RetryPOST
+	default:
+		return false
+	}
+}
+
+// delay computes how long to wait before the next attempt, honoring a
+// Retry-After header on resp if present and otherwise backing off
+// exponentially from BaseDelay.
+func (p *RetryPolicy) delay(resp *Response, attempt int) time.Duration {
+	if resp != nil {
+		if d, ok := retryAfter(resp.Header); ok {
+			if p.MaxDelay > 0 && d > p.MaxDelay {
+				d = p.MaxDelay
+			}
+			return d
+		}
+	}
+
+	d := p.BaseDelay << uint(attempt)
+	if p.Jitter > 0 {
+		d += time.Duration(rand.Int63n(int64(p.Jitter) + 1))
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	return d
+}
+
+// retryAfter parses the Retry-After header, which Asana sends as either a
+// number of seconds or an HTTP-date.
+func retryAfter(h http.Header) (time.Duration, bool) {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// waitContext blocks for d, returning early with ctx.Err() if ctx is done
+// first.
+func waitContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
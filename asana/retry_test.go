@@ -0,0 +1,115 @@
+package asana
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryAfterSeconds(t *testing.T) {
+	h := http.Header{}
+	h.Set("Retry-After", "30")
+
+	d, ok := retryAfter(h)
+	if !ok {
+		t.Fatal("retryAfter reported no value for a numeric header")
+	}
+	if d != 30*time.Second {
+		t.Errorf("retryAfter = %v, want %v", d, 30*time.Second)
+	}
+}
+
+func TestRetryAfterHTTPDate(t *testing.T) {
+	future := time.Now().Add(45 * time.Second)
+
+	h := http.Header{}
+	h.Set("Retry-After", future.UTC().Format(http.TimeFormat))
+
+	d, ok := retryAfter(h)
+	if !ok {
+		t.Fatal("retryAfter reported no value for an HTTP-date header")
+	}
+	// Allow a little slack for the time.Now() called inside retryAfter.
+	if d <= 0 || d > 46*time.Second {
+		t.Errorf("retryAfter = %v, want roughly %v", d, 45*time.Second)
+	}
+}
+
+func TestRetryAfterHTTPDateInPast(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+
+	h := http.Header{}
+	h.Set("Retry-After", past.UTC().Format(http.TimeFormat))
+
+	d, ok := retryAfter(h)
+	if !ok {
+		t.Fatal("retryAfter reported no value for a past HTTP-date header")
+	}
+	if d != 0 {
+		t.Errorf("retryAfter = %v, want 0 for a date already in the past", d)
+	}
+}
+
+func TestRetryAfterMissingOrInvalid(t *testing.T) {
+	if _, ok := retryAfter(http.Header{}); ok {
+		t.Error("retryAfter reported a value for an absent header")
+	}
+
+	h := http.Header{}
+	h.Set("Retry-After", "not-a-number-or-a-date")
+	if _, ok := retryAfter(h); ok {
+		t.Error("retryAfter reported a value for an unparsable header")
+	}
+}
+
+func TestRetryPolicyDelayHonorsRetryAfterHeader(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: time.Millisecond, MaxDelay: time.Minute}
+
+	h := http.Header{}
+	h.Set("Retry-After", "5")
+	resp := &Response{Response: &http.Response{Header: h}}
+
+	if got := p.delay(resp, 0); got != 5*time.Second {
+		t.Errorf("delay = %v, want %v", got, 5*time.Second)
+	}
+}
+
+func TestRetryPolicyDelayExponentialBackoff(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: 100 * time.Millisecond}
+
+	for attempt, want := range map[int]time.Duration{
+		0: 100 * time.Millisecond,
+		1: 200 * time.Millisecond,
+		2: 400 * time.Millisecond,
+	} {
+		if got := p.delay(nil, attempt); got != want {
+			t.Errorf("delay(nil, %d) = %v, want %v", attempt, got, want)
+		}
+	}
+}
+
+func TestRetryPolicyDelayAddsJitterWithinBounds(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: 100 * time.Millisecond, Jitter: 50 * time.Millisecond}
+
+	for i := 0; i < 20; i++ {
+		got := p.delay(nil, 0)
+		if got < 100*time.Millisecond || got > 150*time.Millisecond {
+			t.Fatalf("delay = %v, want within [%v, %v]", got, 100*time.Millisecond, 150*time.Millisecond)
+		}
+	}
+}
+
+func TestRetryPolicyDelayRespectsMaxDelay(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: time.Second, MaxDelay: 2 * time.Second}
+
+	if got := p.delay(nil, 10); got != 2*time.Second {
+		t.Errorf("delay(nil, 10) = %v, want capped at %v", got, 2*time.Second)
+	}
+
+	h := http.Header{}
+	h.Set("Retry-After", "3600")
+	resp := &Response{Response: &http.Response{Header: h}}
+	if got := p.delay(resp, 0); got != 2*time.Second {
+		t.Errorf("delay with large Retry-After = %v, want capped at %v", got, 2*time.Second)
+	}
+}
@@ -0,0 +1,53 @@
+package asana
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// Tag represents an Asana tag.
+type Tag struct {
+	ID   int64  `json:"id"`
+	GID  string `json:"gid"`
+	Name string `json:"name"`
+}
+
+// UnmarshalJSON decodes a Tag, reconciling a numeric id and string gid into
+// both ID and GID regardless of which the server sent.
+func (t *Tag) UnmarshalJSON(data []byte) error {
+	type alias Tag
+	aux := &struct {
+		ID  json.RawMessage `json:"id"`
+		GID json.RawMessage `json:"gid"`
+		*alias
+	}{alias: (*alias)(t)}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	id, gid, err := decodeIdentity(aux.ID, aux.GID)
+	if err != nil {
+		return err
+	}
+	t.ID, t.GID = id, gid
+
+	return nil
+}
+
+// ListTags returns tags matching opt, which may be nil.
+func (c *Client) ListTags(ctx context.Context, opt url.Values) ([]Tag, *Response, error) {
+	req, err := c.NewRequest("GET", withQuery("tags", opt), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tags []Tag
+	resp, err := c.Do(ctx, req, &tags)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tags, resp, nil
+}
@@ -0,0 +1,142 @@
+package asana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Task represents an Asana task.
+type Task struct {
+	ID    int64  `json:"id"`
+	GID   string `json:"gid"`
+	Name  string `json:"name"`
+	Notes string `json:"notes,omitempty"`
+}
+
+// UnmarshalJSON decodes a Task, reconciling a numeric id and string gid into
+// both ID and GID regardless of which the server sent.
+func (t *Task) UnmarshalJSON(data []byte) error {
+	type alias Task
+	aux := &struct {
+		ID  json.RawMessage `json:"id"`
+		GID json.RawMessage `json:"gid"`
+		*alias
+	}{alias: (*alias)(t)}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	id, gid, err := decodeIdentity(aux.ID, aux.GID)
+	if err != nil {
+		return err
+	}
+	t.ID, t.GID = id, gid
+
+	return nil
+}
+
+// TaskUpdate carries the fields to change on a task. Only non-nil fields
+// are sent to the API.
+type TaskUpdate struct {
+	Notes *string `json:"notes,omitempty"`
+}
+
+// ListTasks returns tasks matching opt, which may be nil, along with the
+// Response describing the page fetched.
+func (c *Client) ListTasks(ctx context.Context, opt *TaskListOptions) ([]Task, *Response, error) {
+	u, err := addOptions("tasks", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tasks []Task
+	resp, err := c.Do(ctx, req, &tasks)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tasks, resp, nil
+}
+
+// GetTask returns the task identified by id.
+func (c *Client) GetTask(ctx context.Context, id int64) (Task, error) {
+	return c.getTask(ctx, fmt.Sprintf("tasks/%d", id))
+}
+
+// GetTaskByGID returns the task identified by gid.
+func (c *Client) GetTaskByGID(ctx context.Context, gid string) (Task, error) {
+	return c.getTask(ctx, "tasks/"+gid)
+}
+
+func (c *Client) getTask(ctx context.Context, path string) (Task, error) {
+	req, err := c.NewRequest("GET", path, nil)
+	if err != nil {
+		return Task{}, err
+	}
+
+	var task Task
+	if _, err := c.Do(ctx, req, &task); err != nil {
+		return Task{}, err
+	}
+
+	return task, nil
+}
+
+// UpdateTask updates the task identified by id and returns the updated
+// task. opt may be nil.
+func (c *Client) UpdateTask(ctx context.Context, id int64, update TaskUpdate, opt url.Values) (Task, error) {
+	return c.updateTask(ctx, fmt.Sprintf("tasks/%d", id), update, opt)
+}
+
+// UpdateTaskByGID updates the task identified by gid and returns the
+// updated task. opt may be nil.
+func (c *Client) UpdateTaskByGID(ctx context.Context, gid string, update TaskUpdate, opt url.Values) (Task, error) {
+	return c.updateTask(ctx, "tasks/"+gid, update, opt)
+}
+
+func (c *Client) updateTask(ctx context.Context, path string, update TaskUpdate, opt url.Values) (Task, error) {
+	body := struct {
+		Data TaskUpdate `json:"data"`
+	}{Data: update}
+
+	req, err := c.NewRequest("PUT", withQuery(path, opt), body)
+	if err != nil {
+		return Task{}, err
+	}
+
+	var task Task
+	if _, err := c.Do(ctx, req, &task); err != nil {
+		return Task{}, err
+	}
+
+	return task, nil
+}
+
+// CreateTask creates a task from fields, a set of Asana task field names to
+// values, and returns the created task. opt may be nil.
+func (c *Client) CreateTask(ctx context.Context, fields map[string]string, opt url.Values) (Task, error) {
+	values := url.Values{}
+	for k, v := range fields {
+		values.Set(k, v)
+	}
+
+	req, err := c.NewFormRequest("POST", withQuery("tasks", opt), values)
+	if err != nil {
+		return Task{}, err
+	}
+
+	var task Task
+	if _, err := c.Do(ctx, req, &task); err != nil {
+		return Task{}, err
+	}
+
+	return task, nil
+}
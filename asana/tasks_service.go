@@ -0,0 +1,46 @@
+package asana
+
+import (
+	"context"
+)
+
+// TasksService groups helpers built on top of Client's task methods.
+type TasksService struct {
+	client *Client
+}
+
+// ListAll calls fn with every page of tasks matching opt, following
+// Response.NextPage until Asana reports no further pages. opt may be nil;
+// it is not mutated. Returns the first error from fetching a page or from
+// fn.
+func (s *TasksService) ListAll(ctx context.Context, opt *TaskListOptions, fn func([]Task) error) error {
+	page := cloneTaskListOptions(opt)
+
+	for {
+		tasks, resp, err := s.client.ListTasks(ctx, page)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(tasks); err != nil {
+			return err
+		}
+
+		if resp.NextPage == nil || resp.NextPage.Offset == "" {
+			return nil
+		}
+
+		if page == nil {
+			page = &TaskListOptions{}
+		}
+		page.Offset = resp.NextPage.Offset
+	}
+}
+
+func cloneTaskListOptions(opt *TaskListOptions) *TaskListOptions {
+	if opt == nil {
+		return nil
+	}
+	clone := *opt
+	return &clone
+}
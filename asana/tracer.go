@@ -0,0 +1,45 @@
+package asana
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptrace"
+	"time"
+)
+
+// HTTPTracer receives callbacks around a Client's HTTP round trips.
+// OnRequest fires right before a request is sent (including retries);
+// OnResponse fires once per attempt, whether it succeeded or failed, with
+// the elapsed latency.
+type HTTPTracer struct {
+	OnRequest  func(req *http.Request)
+	OnResponse func(resp *http.Response, latency time.Duration)
+}
+
+// TraceHooks are callbacks fired at the connection-level phases of a single
+// HTTP round trip (DNS, connect, TLS, first byte). Pass the result of
+// NewClientTrace(hooks) to httptrace.WithClientTrace on a request's context
+// to wire these into, for example, OpenTelemetry spans.
+type TraceHooks struct {
+	DNSStart             func(httptrace.DNSStartInfo)
+	DNSDone              func(httptrace.DNSDoneInfo)
+	ConnectStart         func(network, addr string)
+	ConnectDone          func(network, addr string, err error)
+	TLSHandshakeStart    func()
+	TLSHandshakeDone     func(tls.ConnectionState, error)
+	GotFirstResponseByte func()
+}
+
+// NewClientTrace builds an *httptrace.ClientTrace from hooks, leaving any
+// unset callback nil so httptrace skips it.
+func NewClientTrace(hooks TraceHooks) *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart:             hooks.DNSStart,
+		DNSDone:              hooks.DNSDone,
+		ConnectStart:         hooks.ConnectStart,
+		ConnectDone:          hooks.ConnectDone,
+		TLSHandshakeStart:    hooks.TLSHandshakeStart,
+		TLSHandshakeDone:     hooks.TLSHandshakeDone,
+		GotFirstResponseByte: hooks.GotFirstResponseByte,
+	}
+}
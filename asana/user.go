@@ -0,0 +1,59 @@
+package asana
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// User represents an Asana user.
+type User struct {
+	ID    int64  `json:"id"`
+	GID   string `json:"gid"`
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+// UnmarshalJSON decodes a User, reconciling a numeric id and string gid into
+// both ID and GID regardless of which the server sent.
+func (u *User) UnmarshalJSON(data []byte) error {
+	type alias User
+	aux := &struct {
+		ID  json.RawMessage `json:"id"`
+		GID json.RawMessage `json:"gid"`
+		*alias
+	}{alias: (*alias)(u)}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	id, gid, err := decodeIdentity(aux.ID, aux.GID)
+	if err != nil {
+		return err
+	}
+	u.ID, u.GID = id, gid
+
+	return nil
+}
+
+// ListUsers returns the users visible to the authenticated user. opt may be
+// nil.
+func (c *Client) ListUsers(ctx context.Context, opt *UserListOptions) ([]User, *Response, error) {
+	u, err := addOptions("users", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []User
+	resp, err := c.Do(ctx, req, &users)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return users, resp, nil
+}
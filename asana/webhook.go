@@ -0,0 +1,167 @@
+package asana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Resource identifies the Asana object (a project, task, etc.) that a
+// webhook is attached to.
+type Resource struct {
+	ID   int64  `json:"id"`
+	GID  string `json:"gid"`
+	Name string `json:"name"`
+}
+
+// UnmarshalJSON decodes a Resource, reconciling a numeric id and string gid
+// into both ID and GID regardless of which the server sent.
+func (r *Resource) UnmarshalJSON(data []byte) error {
+	type alias Resource
+	aux := &struct {
+		ID  json.RawMessage `json:"id"`
+		GID json.RawMessage `json:"gid"`
+		*alias
+	}{alias: (*alias)(r)}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	id, gid, err := decodeIdentity(aux.ID, aux.GID)
+	if err != nil {
+		return err
+	}
+	r.ID, r.GID = id, gid
+
+	return nil
+}
+
+// Webhook represents a webhook subscription registered with Asana.
+type Webhook struct {
+	ID       int64    `json:"id"`
+	GID      string   `json:"gid"`
+	Resource Resource `json:"resource"`
+	Target   string   `json:"target"`
+	Active   bool     `json:"active"`
+}
+
+// UnmarshalJSON decodes a Webhook, reconciling a numeric id and string gid
+// into both ID and GID regardless of which the server sent.
+func (w *Webhook) UnmarshalJSON(data []byte) error {
+	type alias Webhook
+	aux := &struct {
+		ID  json.RawMessage `json:"id"`
+		GID json.RawMessage `json:"gid"`
+		*alias
+	}{alias: (*alias)(w)}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	id, gid, err := decodeIdentity(aux.ID, aux.GID)
+	if err != nil {
+		return err
+	}
+	w.ID, w.GID = id, gid
+
+	return nil
+}
+
+// GetWebhook returns the webhook identified by id.
+func (c *Client) GetWebhook(ctx context.Context, id int64) (Webhook, error) {
+	return c.getWebhook(ctx, fmt.Sprintf("webhooks/%d", id))
+}
+
+// GetWebhookByGID returns the webhook identified by gid.
+func (c *Client) GetWebhookByGID(ctx context.Context, gid string) (Webhook, error) {
+	return c.getWebhook(ctx, "webhooks/"+gid)
+}
+
+func (c *Client) getWebhook(ctx context.Context, path string) (Webhook, error) {
+	req, err := c.NewRequest("GET", path, nil)
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	var webhook Webhook
+	if _, err := c.Do(ctx, req, &webhook); err != nil {
+		return Webhook{}, err
+	}
+
+	return webhook, nil
+}
+
+// GetWebhooks returns webhooks matching opt, which may be nil.
+func (c *Client) GetWebhooks(ctx context.Context, opt *WebhookListOptions) ([]Webhook, *Response, error) {
+	u, err := addOptions("webhooks", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var webhooks []Webhook
+	resp, err := c.Do(ctx, req, &webhooks)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return webhooks, resp, nil
+}
+
+// CreateWebhook registers a new webhook on resourceID, delivering events to
+// target.
+func (c *Client) CreateWebhook(ctx context.Context, resourceID int64, target string) (Webhook, error) {
+	return c.createWebhook(ctx, fmt.Sprintf("%d", resourceID), target)
+}
+
+// CreateWebhookByGID registers a new webhook on resourceGID, delivering
+// events to target.
+func (c *Client) CreateWebhookByGID(ctx context.Context, resourceGID string, target string) (Webhook, error) {
+	return c.createWebhook(ctx, resourceGID, target)
+}
+
+func (c *Client) createWebhook(ctx context.Context, resource string, target string) (Webhook, error) {
+	values := url.Values{
+		"resource": {resource},
+		"target":   {target},
+	}
+
+	req, err := c.NewFormRequest("POST", "webhooks", values)
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	var webhook Webhook
+	if _, err := c.Do(ctx, req, &webhook); err != nil {
+		return Webhook{}, err
+	}
+
+	return webhook, nil
+}
+
+// DeleteWebhook removes the webhook identified by id.
+func (c *Client) DeleteWebhook(ctx context.Context, id int64) error {
+	return c.deleteWebhook(ctx, fmt.Sprintf("webhooks/%d", id))
+}
+
+// DeleteWebhookByGID removes the webhook identified by gid.
+func (c *Client) DeleteWebhookByGID(ctx context.Context, gid string) error {
+	return c.deleteWebhook(ctx, "webhooks/"+gid)
+}
+
+func (c *Client) deleteWebhook(ctx context.Context, path string) error {
+	req, err := c.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(ctx, req, nil)
+	return err
+}
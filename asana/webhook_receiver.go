@@ -0,0 +1,190 @@
+package asana
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Header names used in the Asana webhook handshake and delivery protocol.
+const (
+	hookSecretHeader    = "X-Hook-Secret"
+	hookSignatureHeader = "X-Hook-Signature"
+)
+
+// maxWebhookBodyBytes caps how much of a request body ServeHTTP will read,
+// since it is reachable from the internet.
+const maxWebhookBodyBytes = 1 << 20 // 1 MiB
+
+// WebhookEvent represents a single change delivered in a webhook payload.
+type WebhookEvent struct {
+	Action    string    `json:"action"`
+	Resource  Resource  `json:"resource"`
+	Parent    *Resource `json:"parent,omitempty"`
+	User      *User     `json:"user,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	Change    *struct {
+		Field      string      `json:"field"`
+		Action     string      `json:"action"`
+		AddedValue interface{} `json:"added_value,omitempty"`
+		NewValue   interface{} `json:"new_value,omitempty"`
+	} `json:"change,omitempty"`
+}
+
+// SecretStore persists the per-webhook secret handed out during the Asana
+// handshake, keyed by the URL path of the endpoint the handshake arrived on
+// (WebhookHandler uses the request's URL path, not the full target URL), so
+// it can be looked up again when deliveries arrive.
+type SecretStore interface {
+	SetSecret(target, secret string) error
+	GetSecret(target string) (string, error)
+
+	// SetSecretIfAbsent stores secret for target and reports true, unless a
+	// secret is already stored for target, in which case it leaves the
+	// existing value untouched and reports false. Implementations must
+	// perform the check and the write atomically, so that two concurrent
+	// handshakes for the same target can't both "win".
+	SetSecretIfAbsent(target, secret string) (bool, error)
+}
+
+// MemorySecretStore is an in-memory SecretStore, suitable as a default or
+// for tests. It does not persist across process restarts.
+type MemorySecretStore struct {
+	mu      sync.RWMutex
+	secrets map[string]string
+}
+
+// NewMemorySecretStore returns an empty MemorySecretStore.
+func NewMemorySecretStore() *MemorySecretStore {
+	return &MemorySecretStore{secrets: make(map[string]string)}
+}
+
+// SetSecret implements SecretStore.
+func (s *MemorySecretStore) SetSecret(target, secret string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.secrets[target] = secret
+	return nil
+}
+
+// GetSecret implements SecretStore.
+func (s *MemorySecretStore) GetSecret(target string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.secrets[target], nil
+}
+
+// SetSecretIfAbsent implements SecretStore.
+func (s *MemorySecretStore) SetSecretIfAbsent(target, secret string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.secrets[target] != "" {
+		return false, nil
+	}
+	s.secrets[target] = secret
+	return true, nil
+}
+
+// WebhookHandler implements http.Handler, receiving Asana webhook
+// handshakes and deliveries for a single endpoint. On the initial
+// handshake request it echoes X-Hook-Secret back to Asana and stores it in
+// Store; on subsequent deliveries it validates X-Hook-Signature against
+// the stored secret before invoking Handle.
+type WebhookHandler struct {
+	// Store holds the secret negotiated during the handshake, keyed by the
+	// request's target (its URL path). Defaults to a MemorySecretStore.
+	Store SecretStore
+
+	// Handle is called with the events carried by a verified delivery.
+	Handle func(events []WebhookEvent)
+}
+
+// NewWebhookHandler returns a WebhookHandler backed by an in-memory
+// SecretStore, dispatching verified deliveries to handle.
+func NewWebhookHandler(handle func(events []WebhookEvent)) *WebhookHandler {
+	return &WebhookHandler{
+		Store:  NewMemorySecretStore(),
+		Handle: handle,
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxWebhookBodyBytes)
+
+	if secret := r.Header.Get(hookSecretHeader); secret != "" {
+		stored, err := h.Store.SetSecretIfAbsent(r.URL.Path, secret)
+		if err != nil {
+			http.Error(w, "failed to store secret", http.StatusInternalServerError)
+			return
+		}
+		if !stored {
+			// A secret has already been negotiated for this target.
+			// Accepting a second handshake would let anyone who can reach
+			// this endpoint overwrite it with a secret of their choosing
+			// and then forge deliveries signed with it.
+			http.Error(w, "handshake already completed", http.StatusConflict)
+			return
+		}
+		w.Header().Set(hookSecretHeader, secret)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	secret, err := h.Store.GetSecret(r.URL.Path)
+	if err != nil || secret == "" {
+		http.Error(w, "unknown webhook", http.StatusUnauthorized)
+		return
+	}
+
+	if !verifySignature(secret, body, r.Header.Get(hookSignatureHeader)) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	var payload struct {
+		Events []WebhookEvent `json:"events"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if h.Handle != nil {
+		h.Handle(payload.Events)
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// verifySignature reports whether sig is the hex-encoded HMAC-SHA256 of
+// body keyed by secret.
+func verifySignature(secret string, body []byte, sig string) bool {
+	want, err := hex.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	got := mac.Sum(nil)
+
+	return subtle.ConstantTimeCompare(got, want) == 1
+}
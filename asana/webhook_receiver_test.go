@@ -0,0 +1,136 @@
+package asana
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestWebhookHandlerHandshake(t *testing.T) {
+	h := NewWebhookHandler(nil)
+
+	req := httptest.NewRequest("POST", "/webhook/666", nil)
+	req.Header.Set(hookSecretHeader, "s3cr3t")
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("handshake status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if got := w.Header().Get(hookSecretHeader); got != "s3cr3t" {
+		t.Errorf("handshake echoed secret = %q, want %q", got, "s3cr3t")
+	}
+
+	secret, err := h.Store.GetSecret("/webhook/666")
+	if err != nil {
+		t.Fatalf("GetSecret returned error: %v", err)
+	}
+	if secret != "s3cr3t" {
+		t.Errorf("stored secret = %q, want %q", secret, "s3cr3t")
+	}
+}
+
+func TestWebhookHandlerHandshakeRejectsReplacementSecret(t *testing.T) {
+	h := NewWebhookHandler(nil)
+	h.Store.SetSecret("/webhook/666", "real-secret")
+
+	req := httptest.NewRequest("POST", "/webhook/666", nil)
+	req.Header.Set(hookSecretHeader, "attacker-secret")
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusConflict {
+		t.Errorf("handshake status = %d, want %d", w.Code, http.StatusConflict)
+	}
+
+	secret, err := h.Store.GetSecret("/webhook/666")
+	if err != nil {
+		t.Fatalf("GetSecret returned error: %v", err)
+	}
+	if secret != "real-secret" {
+		t.Errorf("stored secret = %q, want unchanged %q", secret, "real-secret")
+	}
+}
+
+func TestWebhookHandlerHandshakeConcurrentRaceHasOneWinner(t *testing.T) {
+	h := NewWebhookHandler(nil)
+
+	const attempts = 50
+	var wg sync.WaitGroup
+	codes := make([]int, attempts)
+
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			req := httptest.NewRequest("POST", "/webhook/666", nil)
+			req.Header.Set(hookSecretHeader, fmt.Sprintf("secret-%d", i))
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			codes[i] = w.Code
+		}(i)
+	}
+	wg.Wait()
+
+	var winners int
+	for _, code := range codes {
+		if code == http.StatusOK {
+			winners++
+		} else if code != http.StatusConflict {
+			t.Errorf("unexpected handshake status %d", code)
+		}
+	}
+	if winners != 1 {
+		t.Errorf("got %d winning handshakes, want exactly 1", winners)
+	}
+}
+
+func TestWebhookHandlerDelivery(t *testing.T) {
+	var got []WebhookEvent
+	h := NewWebhookHandler(func(events []WebhookEvent) { got = events })
+	h.Store.SetSecret("/webhook/666", "s3cr3t")
+
+	body := []byte(`{"events":[{"action":"changed","resource":{"id":1,"name":"Task 1"}}]}`)
+	mac := hmac.New(sha256.New, []byte("s3cr3t"))
+	mac.Write(body)
+	sig := hex.EncodeToString(mac.Sum(nil))
+
+	req := httptest.NewRequest("POST", "/webhook/666", bytes.NewReader(body))
+	req.Header.Set(hookSignatureHeader, sig)
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("delivery status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if len(got) != 1 || got[0].Action != "changed" || got[0].Resource.Name != "Task 1" {
+		t.Errorf("handler received events %+v, want a single 'changed' event for Task 1", got)
+	}
+}
+
+func TestWebhookHandlerInvalidSignature(t *testing.T) {
+	h := NewWebhookHandler(func(events []WebhookEvent) {
+		t.Error("handler should not be called for an invalid signature")
+	})
+	h.Store.SetSecret("/webhook/666", "s3cr3t")
+
+	body := []byte(`{"events":[]}`)
+	req := httptest.NewRequest("POST", "/webhook/666", bytes.NewReader(body))
+	req.Header.Set(hookSignatureHeader, hex.EncodeToString([]byte("not-the-right-mac-bytes")))
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+}
@@ -0,0 +1,51 @@
+package asana
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Workspace represents an Asana workspace or organization.
+type Workspace struct {
+	ID   int64  `json:"id"`
+	GID  string `json:"gid"`
+	Name string `json:"name"`
+}
+
+// UnmarshalJSON decodes a Workspace, reconciling a numeric id and string gid
+// into both ID and GID regardless of which the server sent.
+func (w *Workspace) UnmarshalJSON(data []byte) error {
+	type alias Workspace
+	aux := &struct {
+		ID  json.RawMessage `json:"id"`
+		GID json.RawMessage `json:"gid"`
+		*alias
+	}{alias: (*alias)(w)}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	id, gid, err := decodeIdentity(aux.ID, aux.GID)
+	if err != nil {
+		return err
+	}
+	w.ID, w.GID = id, gid
+
+	return nil
+}
+
+// ListWorkspaces returns the workspaces visible to the authenticated user.
+func (c *Client) ListWorkspaces(ctx context.Context) ([]Workspace, error) {
+	req, err := c.NewRequest("GET", "workspaces", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var workspaces []Workspace
+	if _, err := c.Do(ctx, req, &workspaces); err != nil {
+		return nil, err
+	}
+
+	return workspaces, nil
+}